@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+)
+
+// ageRecipient encrypts to a single age X25519 recipient (an "age1..."
+// public key, as produced by `age-keygen`).
+type ageRecipient struct {
+	recipient *age.X25519Recipient
+}
+
+// NewAgeRecipient parses s as an age X25519 recipient string.
+func NewAgeRecipient(s string) (Recipient, error) {
+	recipient, err := age.ParseX25519Recipient(s)
+	if err != nil {
+		return nil, fmt.Errorf("parsing age recipient: %w", err)
+	}
+
+	return ageRecipient{recipient: recipient}, nil
+}
+
+func (r ageRecipient) Encrypt(plaintext []byte) (string, string, error) {
+	var buf bytes.Buffer
+
+	armorWriter := armor.NewWriter(&buf)
+
+	w, err := age.Encrypt(armorWriter, r.recipient)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypting for age recipient: %w", err)
+	}
+	if _, err := io.Copy(w, bytes.NewReader(plaintext)); err != nil {
+		return "", "", fmt.Errorf("encrypting for age recipient: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", "", fmt.Errorf("encrypting for age recipient: %w", err)
+	}
+	if err := armorWriter.Close(); err != nil {
+		return "", "", fmt.Errorf("encrypting for age recipient: %w", err)
+	}
+
+	return buf.String(), r.recipient.String(), nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+
+	kmssvc "github.com/aws/aws-sdk-go/service/kms"
+)
+
+// kmsRecipient encrypts directly with a KMS key, for operators who want the
+// secret recoverable only through KMS (and its associated key policy,
+// CloudTrail logging, and grants) rather than a key only they hold.
+type kmsRecipient struct {
+	conn  kmsiface.KMSAPI
+	keyID string
+}
+
+// NewKMSRecipient returns a Recipient that encrypts with the given KMS key
+// (accepts a key ID, alias, or ARN) using conn.
+func NewKMSRecipient(conn kmsiface.KMSAPI, keyID string) Recipient {
+	return kmsRecipient{conn: conn, keyID: keyID}
+}
+
+func (r kmsRecipient) Encrypt(plaintext []byte) (string, string, error) {
+	output, err := r.conn.Encrypt(&kmssvc.EncryptInput{
+		KeyId:     aws.String(r.keyID),
+		Plaintext: plaintext,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("encrypting with KMS key %q: %w", r.keyID, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(output.CiphertextBlob), aws.StringValue(output.KeyId), nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package encryption provides a small, resource-agnostic mechanism for
+// handing a plaintext secret (an IAM console password, a database master
+// password, a Secrets Manager value, ...) back to a specific human operator
+// without ever writing the plaintext to Terraform state. A Recipient knows
+// how to encrypt a value for exactly one operator-controlled key; resources
+// choose a Recipient implementation based on how the operator identified
+// themselves (an age recipient, an SSH public key, or a KMS key ARN) and
+// store only the resulting ciphertext and fingerprint in state. PGP
+// (including keybase handles) is handled separately by each resource's own
+// historical retrieveGPGKey/encryptValue helpers, since resolving a keybase
+// handle requires a network call this package does not make.
+package encryption
+
+import (
+	"errors"
+	"strings"
+)
+
+// Recipient encrypts a plaintext secret for a single named operator.
+// Implementations return the ciphertext in whatever armored/encoded format
+// is idiomatic for the recipient type (age's ASCII armor or base64-encoded
+// KMS ciphertext) along with a fingerprint that identifies the key used, so
+// operators and tooling can confirm which key a given ciphertext was
+// encrypted for without decrypting it.
+type Recipient interface {
+	Encrypt(plaintext []byte) (ciphertext string, fingerprint string, err error)
+}
+
+// ErrUnrecognizedRecipient is returned by ParseRecipient when the supplied
+// string does not match any known recipient format.
+var ErrUnrecognizedRecipient = errors.New("unrecognized encryption recipient: expected an age recipient (age1...), SSH public key (ssh-ed25519/ssh-rsa ...), or KMS key ARN")
+
+// ParseRecipient inspects s and returns the Recipient implementation that
+// matches its format. It does not make any network calls and does not
+// handle PGP keys or keybase handles; callers that accept those should
+// check for them before falling back to ParseRecipient.
+func ParseRecipient(s string) (Recipient, error) {
+	switch {
+	case strings.HasPrefix(s, "age1"):
+		return NewAgeRecipient(s)
+	case strings.HasPrefix(s, "ssh-"):
+		return NewSSHRecipient(s)
+	case strings.HasPrefix(s, "arn:") && strings.Contains(s, ":kms:"):
+		return nil, errors.New("KMS recipients must be constructed with NewKMSRecipient, which requires a KMS client")
+	default:
+		return nil, ErrUnrecognizedRecipient
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseRecipient(t *testing.T) {
+	t.Parallel()
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("age.GenerateX25519Identity() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		in      string
+		wantErr bool
+	}{
+		"age recipient":    {in: identity.Recipient().String()},
+		"unrecognized":     {in: "not-a-recipient", wantErr: true},
+		"kms arn rejected": {in: "arn:aws:kms:us-east-1:123456789012:key/abcd", wantErr: true},
+		"empty string":     {in: "", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseRecipient(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRecipient(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseRecipientSSH(t *testing.T) {
+	t.Parallel()
+
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(testSSHAuthorizedKey)); err != nil {
+		t.Fatalf("ssh.ParseAuthorizedKey() error = %v", err)
+	}
+
+	recipient, err := ParseRecipient(strings.TrimSpace(testSSHAuthorizedKey))
+	if err != nil {
+		t.Fatalf("ParseRecipient() error = %v", err)
+	}
+
+	ciphertext, fingerprint, err := recipient.Encrypt([]byte("hunter2"))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if ciphertext == "" {
+		t.Error("Encrypt() returned an empty ciphertext")
+	}
+	if fingerprint == "" {
+		t.Error("Encrypt() returned an empty fingerprint")
+	}
+}
+
+func TestAgeRecipientEncryptRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("age.GenerateX25519Identity() error = %v", err)
+	}
+
+	recipient, err := NewAgeRecipient(identity.Recipient().String())
+	if err != nil {
+		t.Fatalf("NewAgeRecipient() error = %v", err)
+	}
+
+	const plaintext = "s3cr3t-password"
+
+	ciphertext, fingerprint, err := recipient.Encrypt([]byte(plaintext))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if fingerprint != identity.Recipient().String() {
+		t.Errorf("Encrypt() fingerprint = %q, want %q", fingerprint, identity.Recipient().String())
+	}
+
+	armorReader := armor.NewReader(strings.NewReader(ciphertext))
+	r, err := age.Decrypt(armorReader, identity)
+	if err != nil {
+		t.Fatalf("age.Decrypt() error = %v", err)
+	}
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("io.ReadAll() error = %v", err)
+	}
+	if string(got) != plaintext {
+		t.Errorf("decrypted plaintext = %q, want %q", got, plaintext)
+	}
+}
+
+func TestNewSSHRecipientUnparseableInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSSHRecipient("not-a-valid-authorized-key-line")
+	if err == nil {
+		t.Fatal("NewSSHRecipient() error = nil, want error for unparseable input")
+	}
+}
+
+// testSSHAuthorizedKey is a throwaway ed25519 public key in
+// ~/.ssh/authorized_keys format, used only to exercise NewSSHRecipient's
+// parsing and encryption path.
+const testSSHAuthorizedKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPzBC/Mg7y0GrsaY4Ne4aIspHGzFUaFqAi8vNC58mFRb test@example.com\n"
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"filippo.io/age"
+	"filippo.io/age/agessh"
+	"filippo.io/age/armor"
+	"golang.org/x/crypto/ssh"
+)
+
+// sshRecipient encrypts to an SSH public key via age's SSH recipient
+// adapter, so the same code path the provider already needs for age
+// recipients also serves operators who only have an SSH key on file.
+type sshRecipient struct {
+	recipient   age.Recipient
+	fingerprint string
+}
+
+// NewSSHRecipient parses authorizedKeyLine, a single line in
+// ~/.ssh/authorized_keys format (e.g. "ssh-ed25519 AAAA... comment"), and
+// returns a Recipient that encrypts to it. Only ssh-ed25519 and ssh-rsa keys
+// are supported, matching age's agessh package.
+func NewSSHRecipient(authorizedKeyLine string) (Recipient, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(authorizedKeyLine))
+	if err != nil {
+		return nil, fmt.Errorf("parsing SSH public key: %w", err)
+	}
+
+	fingerprint := ssh.FingerprintSHA256(pub)
+
+	switch pub.Type() {
+	case ssh.KeyAlgoED25519:
+		r, err := agessh.NewEd25519Recipient(pub)
+		if err != nil {
+			return nil, fmt.Errorf("building age recipient from SSH ed25519 key: %w", err)
+		}
+		return sshRecipient{recipient: r, fingerprint: fingerprint}, nil
+	case ssh.KeyAlgoRSA:
+		r, err := agessh.NewRSARecipient(pub)
+		if err != nil {
+			return nil, fmt.Errorf("building age recipient from SSH RSA key: %w", err)
+		}
+		return sshRecipient{recipient: r, fingerprint: fingerprint}, nil
+	default:
+		return nil, fmt.Errorf("unsupported SSH key type %q, expected ssh-ed25519 or ssh-rsa", pub.Type())
+	}
+}
+
+func (r sshRecipient) Encrypt(plaintext []byte) (string, string, error) {
+	var buf bytes.Buffer
+
+	armorWriter := armor.NewWriter(&buf)
+
+	w, err := age.Encrypt(armorWriter, r.recipient)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypting for SSH recipient: %w", err)
+	}
+	if _, err := io.Copy(w, bytes.NewReader(plaintext)); err != nil {
+		return "", "", fmt.Errorf("encrypting for SSH recipient: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", "", fmt.Errorf("encrypting for SSH recipient: %w", err)
+	}
+	if err := armorWriter.Close(); err != nil {
+		return "", "", fmt.Errorf("encrypting for SSH recipient: %w", err)
+	}
+
+	return buf.String(), r.fingerprint, nil
+}
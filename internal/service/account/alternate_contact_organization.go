@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/sync/errgroup"
+)
+
+const alternateContactOrganizationResourceIDSeparator = "/"
+
+// @SDKResource("aws_account_alternate_contact_org_default")
+func ResourceAlternateContactOrgDefault() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAlternateContactOrgDefaultUpsert,
+		ReadWithoutTimeout:   resourceAlternateContactOrgDefaultRead,
+		UpdateWithoutTimeout: resourceAlternateContactOrgDefaultUpsert,
+		DeleteWithoutTimeout: resourceAlternateContactOrgDefaultDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ou_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"ou_id", "account_tag_filter"},
+			},
+			"account_tag_filter": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"ou_id", "account_tag_filter"},
+			},
+			"alternate_contact_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AlternateContactType](),
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"concurrency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
+			},
+
+			"results": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"failures": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// targetAccountIDs resolves the member account IDs that
+// aws_account_alternate_contact_org_default should fan its PutAlternateContact
+// calls out to, either every account under ou_id or every account matching
+// account_tag_filter.
+func targetAccountIDs(ctx context.Context, conn *organizations.Client, d *schema.ResourceData) ([]string, error) {
+	if ouID := d.Get("ou_id").(string); ouID != "" {
+		return accountIDsForParent(ctx, conn, ouID)
+	}
+
+	return accountIDsForTagFilter(ctx, conn, d.Get("account_tag_filter").(map[string]interface{}), d.Get("concurrency").(int))
+}
+
+// accountIDsForParent returns every account under parentID, recursing into
+// child OUs so that an ou_id pointed at a top-level OU covers its whole
+// subtree rather than only its immediate children.
+func accountIDsForParent(ctx context.Context, conn *organizations.Client, parentID string) ([]string, error) {
+	var accountIDs []string
+
+	paginator := organizations.NewListAccountsForParentPaginator(conn, &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing accounts for parent %s: %w", parentID, err)
+		}
+		for _, a := range page.Accounts {
+			accountIDs = append(accountIDs, aws.ToString(a.Id))
+		}
+	}
+
+	ouPaginator := organizations.NewListOrganizationalUnitsForParentPaginator(conn, &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentID),
+	})
+	for ouPaginator.HasMorePages() {
+		page, err := ouPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing child OUs for parent %s: %w", parentID, err)
+		}
+		for _, ou := range page.OrganizationalUnits {
+			childAccountIDs, err := accountIDsForParent(ctx, conn, aws.ToString(ou.Id))
+			if err != nil {
+				return nil, err
+			}
+			accountIDs = append(accountIDs, childAccountIDs...)
+		}
+	}
+
+	return accountIDs, nil
+}
+
+func accountIDsForTagFilter(ctx context.Context, conn *organizations.Client, tagFilter map[string]interface{}, concurrency int) ([]string, error) {
+	paginator := organizations.NewListAccountsPaginator(conn, &organizations.ListAccountsInput{})
+
+	var allAccountIDs []string
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing organization accounts: %w", err)
+		}
+		for _, a := range page.Accounts {
+			allAccountIDs = append(allAccountIDs, aws.ToString(a.Id))
+		}
+	}
+
+	if len(tagFilter) == 0 {
+		return allAccountIDs, nil
+	}
+
+	var mu sync.Mutex
+	var matched []string
+
+	g, gctx := errgroup.WithContext(ctx)
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	g.SetLimit(concurrency)
+
+	for _, accountID := range allAccountIDs {
+		accountID := accountID
+		g.Go(func() error {
+			match, err := accountMatchesTagFilter(gctx, conn, accountID, tagFilter)
+			if err != nil {
+				return err
+			}
+			if match {
+				mu.Lock()
+				matched = append(matched, accountID)
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return matched, nil
+}
+
+func accountMatchesTagFilter(ctx context.Context, conn *organizations.Client, accountID string, tagFilter map[string]interface{}) (bool, error) {
+	output, err := conn.ListTagsForResource(ctx, &organizations.ListTagsForResourceInput{
+		ResourceId: aws.String(accountID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("listing tags for account %s: %w", accountID, err)
+	}
+
+	tags := make(map[string]string, len(output.Tags))
+	for _, t := range output.Tags {
+		tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+
+	for k, v := range tagFilter {
+		if tags[k] != v.(string) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// fanOutAlternateContact applies fn to every account in accountIDs with at
+// most concurrency calls in flight at once, continuing past individual
+// failures and returning the per-account outcomes rather than aborting on
+// the first error.
+func fanOutAlternateContact(ctx context.Context, accountIDs []string, concurrency int, fn func(ctx context.Context, accountID string) error) (succeeded, failed map[string]string) {
+	succeeded = make(map[string]string, len(accountIDs))
+	failed = make(map[string]string)
+	var mu sync.Mutex
+
+	g, gctx := errgroup.WithContext(ctx)
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	g.SetLimit(concurrency)
+
+	for _, accountID := range accountIDs {
+		accountID := accountID
+		g.Go(func() error {
+			err := fn(gctx, accountID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[accountID] = err.Error()
+			} else {
+				succeeded[accountID] = time.Now().UTC().Format(time.RFC3339)
+			}
+
+			// Never return an error here: a per-account failure must not
+			// cancel gctx and abort accounts still in flight.
+			return nil
+		})
+	}
+
+	// g.Wait() only ever returns nil given the above, but its error is
+	// still checked for future-proofing against a change in fn's contract.
+	if err := g.Wait(); err != nil {
+		log.Printf("[WARN] unexpected error from alternate contact fan-out: %s", err)
+	}
+
+	return succeeded, failed
+}
+
+func resourceAlternateContactOrgDefaultUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountClient()
+	orgConn := meta.(*conns.AWSClient).OrganizationsClient()
+
+	contactType := d.Get("alternate_contact_type").(string)
+	concurrency := d.Get("concurrency").(int)
+
+	// ou_id/account_tag_filter are mutable, so an update can narrow the
+	// managed scope; capture the accounts this resource previously managed
+	// before results is overwritten below, so any that fall out of the new
+	// scope can be cleaned up instead of silently abandoned.
+	oldResults := d.Get("results").(map[string]interface{})
+
+	accountIDs, err := targetAccountIDs(ctx, orgConn, d)
+	if err != nil {
+		return diag.Errorf("resolving target accounts for Account Alternate Contact Org Default: %s", err)
+	}
+
+	input := account.PutAlternateContactInput{
+		AlternateContactType: types.AlternateContactType(contactType),
+		EmailAddress:         aws.String(d.Get("email_address").(string)),
+		Name:                 aws.String(d.Get("name").(string)),
+		PhoneNumber:          aws.String(d.Get("phone_number").(string)),
+		Title:                aws.String(d.Get("title").(string)),
+	}
+
+	succeeded, failed := fanOutAlternateContact(ctx, accountIDs, concurrency, func(ctx context.Context, accountID string) error {
+		in := input
+		in.AccountId = aws.String(accountID)
+		_, err := conn.PutAlternateContact(ctx, &in)
+		return err
+	})
+
+	d.Set("results", succeeded)
+	d.Set("failures", failed)
+
+	if d.IsNewResource() {
+		scope := d.Get("ou_id").(string)
+		if scope == "" {
+			scope = "tag-filter"
+		}
+		d.SetId(scope + alternateContactOrganizationResourceIDSeparator + contactType)
+	}
+
+	var diags diag.Diagnostics
+
+	if !d.IsNewResource() {
+		inScope := make(map[string]bool, len(accountIDs))
+		for _, accountID := range accountIDs {
+			inScope[accountID] = true
+		}
+
+		var outOfScope []string
+		for accountID := range oldResults {
+			if !inScope[accountID] {
+				outOfScope = append(outOfScope, accountID)
+			}
+		}
+
+		if len(outOfScope) > 0 {
+			_, cleanupFailed := fanOutAlternateContact(ctx, outOfScope, concurrency, func(ctx context.Context, accountID string) error {
+				_, err := conn.DeleteAlternateContact(ctx, &account.DeleteAlternateContactInput{
+					AlternateContactType: types.AlternateContactType(contactType),
+					AccountId:            aws.String(accountID),
+				})
+				if errs.IsA[*types.ResourceNotFoundException](err) {
+					return nil
+				}
+				return err
+			})
+
+			for accountID, errMsg := range cleanupFailed {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Account Alternate Contact left configured for account %s", accountID),
+					Detail:   fmt.Sprintf("account %s fell out of this resource's scope (ou_id/account_tag_filter changed) but its alternate contact could not be removed: %s", accountID, errMsg),
+				})
+			}
+		}
+	}
+
+	if len(succeeded) == 0 && len(accountIDs) > 0 {
+		return diag.Errorf("setting Account Alternate Contact on every target account failed: %v", failed)
+	}
+
+	for accountID, errMsg := range failed {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Account Alternate Contact not set for account %s", accountID),
+			Detail:   errMsg,
+		})
+	}
+
+	return diags
+}
+
+func resourceAlternateContactOrgDefaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountClient()
+
+	contactType := d.Get("alternate_contact_type").(string)
+	results := d.Get("results").(map[string]interface{})
+
+	// Drift detection only re-reads the subset of accounts this resource
+	// last successfully configured, not the whole organization.
+	refreshed := make(map[string]string, len(results))
+	for accountID := range results {
+		_, err := FindAlternateContactByTwoPartKey(ctx, conn, accountID, contactType)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[DEBUG] Account Alternate Contact for %s no longer exists, dropping from results", accountID)
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("reading Account Alternate Contact for account %s: %s", accountID, err)
+		}
+
+		refreshed[accountID] = results[accountID].(string)
+	}
+	d.Set("results", refreshed)
+
+	return nil
+}
+
+func resourceAlternateContactOrgDefaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountClient()
+
+	contactType := d.Get("alternate_contact_type").(string)
+	results := d.Get("results").(map[string]interface{})
+	concurrency := d.Get("concurrency").(int)
+
+	accountIDs := make([]string, 0, len(results))
+	for accountID := range results {
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	succeeded, failed := fanOutAlternateContact(ctx, accountIDs, concurrency, func(ctx context.Context, accountID string) error {
+		_, err := conn.DeleteAlternateContact(ctx, &account.DeleteAlternateContactInput{
+			AlternateContactType: types.AlternateContactType(contactType),
+			AccountId:            aws.String(accountID),
+		})
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil
+		}
+		return err
+	})
+
+	if len(failed) > 0 {
+		// Leave the accounts that still need deleting in state (as a hard
+		// error, not a warning) so the SDK doesn't drop the resource ID and
+		// a subsequent apply retries only what's left, mirroring
+		// resourceAlternateContactDelete's behavior on failure.
+		remaining := make(map[string]string, len(succeeded))
+		for accountID := range failed {
+			if v, ok := results[accountID]; ok {
+				remaining[accountID] = v.(string)
+			}
+		}
+		d.Set("results", remaining)
+
+		return diag.Errorf("deleting Account Alternate Contact for %d account(s): %v", len(failed), failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFanOutAlternateContactSuccessAndFailure(t *testing.T) {
+	t.Parallel()
+
+	accountIDs := []string{"111111111111", "222222222222", "333333333333"}
+	wantErr := errors.New("boom")
+
+	succeeded, failed := fanOutAlternateContact(context.Background(), accountIDs, 2, func(ctx context.Context, accountID string) error {
+		if accountID == "222222222222" {
+			return wantErr
+		}
+		return nil
+	})
+
+	if len(succeeded) != 2 {
+		t.Errorf("len(succeeded) = %d, want 2", len(succeeded))
+	}
+	if _, ok := succeeded["111111111111"]; !ok {
+		t.Error("succeeded missing 111111111111")
+	}
+	if _, ok := succeeded["333333333333"]; !ok {
+		t.Error("succeeded missing 333333333333")
+	}
+
+	if len(failed) != 1 {
+		t.Fatalf("len(failed) = %d, want 1", len(failed))
+	}
+	if failed["222222222222"] != wantErr.Error() {
+		t.Errorf("failed[222222222222] = %q, want %q", failed["222222222222"], wantErr.Error())
+	}
+}
+
+func TestFanOutAlternateContactRunsEveryAccountDespiteFailures(t *testing.T) {
+	t.Parallel()
+
+	accountIDs := []string{"a", "b", "c", "d", "e"}
+	var calls int32
+
+	_, failed := fanOutAlternateContact(context.Background(), accountIDs, 2, func(ctx context.Context, accountID string) error {
+		atomic.AddInt32(&calls, 1)
+		return errors.New("always fails")
+	})
+
+	if int(calls) != len(accountIDs) {
+		t.Errorf("fn was called %d times, want %d (a failure must not abort the other in-flight accounts)", calls, len(accountIDs))
+	}
+	if len(failed) != len(accountIDs) {
+		t.Errorf("len(failed) = %d, want %d", len(failed), len(accountIDs))
+	}
+}
+
+func TestFanOutAlternateContactConcurrencyFloor(t *testing.T) {
+	t.Parallel()
+
+	succeeded, failed := fanOutAlternateContact(context.Background(), []string{"only-one"}, 0, func(ctx context.Context, accountID string) error {
+		return nil
+	})
+
+	if len(succeeded) != 1 {
+		t.Errorf("len(succeeded) = %d, want 1 (concurrency < 1 must be floored to 1, not block forever)", len(succeeded))
+	}
+	if len(failed) != 0 {
+		t.Errorf("len(failed) = %d, want 0", len(failed))
+	}
+}
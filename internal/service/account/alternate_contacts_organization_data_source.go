@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/sync/errgroup"
+)
+
+// alternateContactTypes are queried for every account, matching the three
+// types the Account API supports (types.AlternateContactType).
+var alternateContactTypes = []string{"BILLING", "OPERATIONS", "SECURITY"}
+
+func alternateContactAttributeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"email_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"phone_number": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"title": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// @SDKDataSource("aws_account_alternate_contacts_organization")
+func DataSourceAlternateContactsOrganization() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAlternateContactsOrganizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"ou_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"concurrency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
+			},
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"billing":    alternateContactAttributeSchema(),
+						"operations": alternateContactAttributeSchema(),
+						"security":   alternateContactAttributeSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlternateContactsOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountClient()
+	orgConn := meta.(*conns.AWSClient).OrganizationsClient()
+
+	ouID := d.Get("ou_id").(string)
+	concurrency := d.Get("concurrency").(int)
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	accountIDs, err := accountIDsForParent(ctx, orgConn, ouID)
+	if err != nil {
+		return diag.Errorf("listing accounts for OU %s: %s", ouID, err)
+	}
+
+	var mu sync.Mutex
+	perAccount := make(map[string]map[string]interface{}, len(accountIDs))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for _, accountID := range accountIDs {
+		accountID := accountID
+		g.Go(func() error {
+			entry := map[string]interface{}{"account_id": accountID}
+
+			for _, contactType := range alternateContactTypes {
+				contact, err := FindAlternateContactByTwoPartKey(gctx, conn, accountID, contactType)
+				if tfresource.NotFound(err) {
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("reading %s alternate contact for account %s: %w", contactType, accountID, err)
+				}
+
+				entry[alternateContactAttributeKey(contactType)] = []interface{}{
+					map[string]interface{}{
+						"email_address": aws.ToString(contact.EmailAddress),
+						"name":          aws.ToString(contact.Name),
+						"phone_number":  aws.ToString(contact.PhoneNumber),
+						"title":         aws.ToString(contact.Title),
+					},
+				}
+			}
+
+			mu.Lock()
+			perAccount[accountID] = entry
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return diag.Errorf("reading Account Alternate Contacts for OU %s: %s", ouID, err)
+	}
+
+	accounts := make([]map[string]interface{}, 0, len(perAccount))
+	for _, entry := range perAccount {
+		accounts = append(accounts, entry)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i]["account_id"].(string) < accounts[j]["account_id"].(string)
+	})
+
+	d.SetId(ouID)
+	d.Set("accounts", accounts)
+
+	return nil
+}
+
+func alternateContactAttributeKey(contactType string) string {
+	switch contactType {
+	case "BILLING":
+		return "billing"
+	case "OPERATIONS":
+		return "operations"
+	case "SECURITY":
+		return "security"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/account/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// contactInformationIDDefault is used as the resource ID when no account_id
+// is set. There is exactly one primary contact per account, so when
+// account_id is set it alone is used as the ID.
+const contactInformationIDDefault = "default"
+
+// @SDKResource("aws_account_contact_information")
+func ResourceContactInformation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactInformationPut,
+		ReadWithoutTimeout:   resourceContactInformationRead,
+		UpdateWithoutTimeout: resourceContactInformationPut,
+		DeleteWithoutTimeout: resourceContactInformationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"address_line_1": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"address_line_2": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 60),
+			},
+			"address_line_3": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 60),
+			},
+			"city": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"company_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"country_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2),
+			},
+			"district_or_county": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"full_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"postal_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"state_or_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"website_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+		},
+	}
+}
+
+func resourceContactInformationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountClient()
+
+	accountID := d.Get("account_id").(string)
+	input := &account.PutContactInformationInput{
+		ContactInformation: expandContactInformation(d),
+	}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	_, err := conn.PutContactInformation(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting Account Contact Information (%s): %s", accountID, err)
+	}
+
+	if d.IsNewResource() {
+		id := accountID
+		if id == "" {
+			id = contactInformationIDDefault
+		}
+		d.SetId(id)
+	}
+
+	const (
+		inARow = 2
+	)
+	_, err = retry.Operation(func(ctx context.Context) (*types.ContactInformation, error) {
+		return FindContactInformationByAccountID(ctx, conn, accountID)
+	}).UntilFoundN(inARow).Run(ctx, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.Errorf("waiting for Account Contact Information (%s) put: %s", d.Id(), err)
+	}
+
+	return resourceContactInformationRead(ctx, d, meta)
+}
+
+func resourceContactInformationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountClient()
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" && d.Id() != contactInformationIDDefault {
+		accountID = d.Id()
+	}
+
+	output, err := FindContactInformationByAccountID(ctx, conn, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Account Contact Information (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Account Contact Information (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	flattenContactInformation(d, output)
+
+	return nil
+}
+
+func resourceContactInformationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The Account API has no DeleteContactInformation operation; every
+	// account must always have primary contact information on file.
+	// Removing this resource just stops Terraform from managing it.
+	log.Printf("[DEBUG] Account Contact Information (%s) can't be deleted via the API, removing from state only", d.Id())
+	return nil
+}
+
+func FindContactInformationByAccountID(ctx context.Context, conn *account.Client, accountID string) (*types.ContactInformation, error) {
+	input := &account.GetContactInformationInput{}
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetContactInformation(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactInformation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContactInformation, nil
+}
+
+func expandContactInformation(d *schema.ResourceData) *types.ContactInformation {
+	contact := &types.ContactInformation{
+		AddressLine1: aws.String(d.Get("address_line_1").(string)),
+		City:         aws.String(d.Get("city").(string)),
+		CountryCode:  aws.String(d.Get("country_code").(string)),
+		FullName:     aws.String(d.Get("full_name").(string)),
+		PhoneNumber:  aws.String(d.Get("phone_number").(string)),
+		PostalCode:   aws.String(d.Get("postal_code").(string)),
+	}
+
+	if v, ok := d.GetOk("address_line_2"); ok {
+		contact.AddressLine2 = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("address_line_3"); ok {
+		contact.AddressLine3 = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("company_name"); ok {
+		contact.CompanyName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("district_or_county"); ok {
+		contact.DistrictOrCounty = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("state_or_region"); ok {
+		contact.StateOrRegion = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("website_url"); ok {
+		contact.WebsiteUrl = aws.String(v.(string))
+	}
+
+	return contact
+}
+
+func flattenContactInformation(d *schema.ResourceData, contact *types.ContactInformation) {
+	d.Set("address_line_1", contact.AddressLine1)
+	d.Set("address_line_2", contact.AddressLine2)
+	d.Set("address_line_3", contact.AddressLine3)
+	d.Set("city", contact.City)
+	d.Set("company_name", contact.CompanyName)
+	d.Set("country_code", contact.CountryCode)
+	d.Set("district_or_county", contact.DistrictOrCounty)
+	d.Set("full_name", contact.FullName)
+	d.Set("phone_number", contact.PhoneNumber)
+	d.Set("postal_code", contact.PostalCode)
+	d.Set("state_or_region", contact.StateOrRegion)
+	d.Set("website_url", contact.WebsiteUrl)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_account_contact_information")
+func DataSourceContactInformation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContactInformationRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"address_line_1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address_line_2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address_line_3": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"city": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"company_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"district_or_county": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"postal_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_or_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceContactInformationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountClient()
+
+	accountID := d.Get("account_id").(string)
+
+	output, err := FindContactInformationByAccountID(ctx, conn, accountID)
+
+	if err != nil {
+		return diag.Errorf("reading Account Contact Information: %s", err)
+	}
+
+	id := accountID
+	if id == "" {
+		id = contactInformationIDDefault
+	}
+	d.SetId(id)
+
+	d.Set("account_id", accountID)
+	flattenContactInformation(d, output)
+
+	return nil
+}
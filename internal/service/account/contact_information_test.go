@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandFlattenContactInformation(t *testing.T) {
+	t.Parallel()
+
+	raw := map[string]interface{}{
+		"address_line_1":     "123 Main St",
+		"address_line_2":     "Suite 100",
+		"city":               "Seattle",
+		"company_name":       "Example Corp",
+		"country_code":       "US",
+		"district_or_county": "King",
+		"full_name":          "Jane Doe",
+		"phone_number":       "+12065551234",
+		"postal_code":        "98101",
+		"state_or_region":    "WA",
+		"website_url":        "https://example.com",
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceContactInformation().Schema, raw)
+
+	contact := expandContactInformation(d)
+
+	if got, want := aws.ToString(contact.AddressLine1), raw["address_line_1"]; got != want {
+		t.Errorf("AddressLine1 = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(contact.FullName), raw["full_name"]; got != want {
+		t.Errorf("FullName = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(contact.CountryCode), raw["country_code"]; got != want {
+		t.Errorf("CountryCode = %q, want %q", got, want)
+	}
+
+	out := schema.TestResourceDataRaw(t, ResourceContactInformation().Schema, map[string]interface{}{})
+	flattenContactInformation(out, &types.ContactInformation{
+		AddressLine1: aws.String("456 Oak Ave"),
+		City:         aws.String("Portland"),
+		CountryCode:  aws.String("US"),
+		FullName:     aws.String("John Smith"),
+		PhoneNumber:  aws.String("+15035551234"),
+		PostalCode:   aws.String("97201"),
+	})
+
+	if got, want := out.Get("address_line_1").(string), "456 Oak Ave"; got != want {
+		t.Errorf("address_line_1 = %q, want %q", got, want)
+	}
+	if got, want := out.Get("city").(string), "Portland"; got != want {
+		t.Errorf("city = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// PasswordPolicy describes the rules a generated IAM login profile password
+// must satisfy. It is exported so that other resources needing to generate a
+// policy-conformant secret (aws_iam_account_password_policy, RDS and
+// Redshift master passwords, DocDB, etc.) can share the same generator
+// instead of rolling their own rejection loop.
+type PasswordPolicy interface {
+	// Charset returns every character the generator is allowed to draw from.
+	Charset() []rune
+	// Validate returns an error describing the first rule the password
+	// violates, or nil if the password satisfies the policy.
+	Validate(password []byte) error
+	// MinEntropyBits is the minimum Shannon entropy, in bits, a password
+	// generated under this policy must have.
+	MinEntropyBits() float64
+}
+
+// classGenerator is implemented by policies that can enumerate their
+// required character classes, letting GeneratePasswordWithPolicy build a
+// password constructively instead of by rejection sampling.
+type classGenerator interface {
+	requiredClasses() [][]rune
+}
+
+// defaultPasswordPolicy is the policy historically enforced by
+// GeneratePassword/CheckPwdPolicy: at least one lowercase, uppercase,
+// numeric, and symbol character drawn from the full IAM-compatible charset.
+type defaultPasswordPolicy struct{}
+
+// NewDefaultPasswordPolicy returns the built-in IAM password policy used when
+// a resource does not configure any of the require_*, forbidden_chars, or
+// min_entropy_bits attributes.
+func NewDefaultPasswordPolicy() PasswordPolicy {
+	return defaultPasswordPolicy{}
+}
+
+func (defaultPasswordPolicy) Charset() []rune {
+	return []rune(charLower + charUpper + charNumbers + charSymbols)
+}
+
+func (defaultPasswordPolicy) Validate(password []byte) error {
+	if !CheckPwdPolicy(password) {
+		return errors.New("password must contain lowercase, uppercase, numeric, and symbol characters")
+	}
+	return nil
+}
+
+func (defaultPasswordPolicy) MinEntropyBits() float64 {
+	return 0
+}
+
+func (defaultPasswordPolicy) requiredClasses() [][]rune {
+	return [][]rune{[]rune(charLower), []rune(charUpper), []rune(charNumbers), []rune(charSymbols)}
+}
+
+// filterForbidden returns class with every rune in forbidden removed. If
+// that leaves nothing to draw from, class is returned unfiltered rather than
+// producing an empty draw pool; generateConstructivePassword's subsequent
+// policy.Validate() check catches the resulting violation and falls back to
+// rejection sampling.
+func filterForbidden(class []rune, forbidden string) []rune {
+	if forbidden == "" {
+		return class
+	}
+
+	filtered := []rune(strings.Map(func(r rune) rune {
+		if strings.ContainsRune(forbidden, r) {
+			return -1
+		}
+		return r
+	}, string(class)))
+
+	if len(filtered) == 0 {
+		return class
+	}
+
+	return filtered
+}
+
+// PasswordPolicyConfig configures a PasswordPolicy, typically populated from
+// a resource's require_lower/require_upper/require_numbers/require_symbols/
+// min_symbol_count/forbidden_chars/min_entropy_bits schema attributes.
+type PasswordPolicyConfig struct {
+	RequireLower   bool
+	RequireUpper   bool
+	RequireNumbers bool
+	RequireSymbols bool
+	MinSymbolCount int
+	ForbiddenChars string
+	MinEntropyBits float64
+}
+
+type configurablePasswordPolicy struct {
+	cfg PasswordPolicyConfig
+}
+
+// NewPasswordPolicy builds a PasswordPolicy from the given configuration.
+func NewPasswordPolicy(cfg PasswordPolicyConfig) PasswordPolicy {
+	return configurablePasswordPolicy{cfg: cfg}
+}
+
+func (p configurablePasswordPolicy) Charset() []rune {
+	charset := charLower + charUpper + charNumbers + charSymbols
+
+	if p.cfg.ForbiddenChars == "" {
+		return []rune(charset)
+	}
+
+	return []rune(strings.Map(func(r rune) rune {
+		if strings.ContainsRune(p.cfg.ForbiddenChars, r) {
+			return -1
+		}
+		return r
+	}, charset))
+}
+
+func (p configurablePasswordPolicy) Validate(password []byte) error {
+	cfg := p.cfg
+
+	if cfg.RequireLower && !bytes.ContainsAny(password, charLower) {
+		return errors.New("password must contain a lowercase character")
+	}
+	if cfg.RequireUpper && !bytes.ContainsAny(password, charUpper) {
+		return errors.New("password must contain an uppercase character")
+	}
+	if cfg.RequireNumbers && !bytes.ContainsAny(password, charNumbers) {
+		return errors.New("password must contain a numeric character")
+	}
+	if cfg.RequireSymbols {
+		if min := minCount(cfg.MinSymbolCount); countAny(password, charSymbols) < min {
+			return fmt.Errorf("password must contain at least %d symbol character(s)", min)
+		}
+	}
+	for _, c := range cfg.ForbiddenChars {
+		if bytes.ContainsRune(password, c) {
+			return fmt.Errorf("password must not contain the character %q", c)
+		}
+	}
+
+	return nil
+}
+
+func (p configurablePasswordPolicy) MinEntropyBits() float64 {
+	return p.cfg.MinEntropyBits
+}
+
+func (p configurablePasswordPolicy) requiredClasses() [][]rune {
+	var classes [][]rune
+
+	if p.cfg.RequireLower {
+		classes = append(classes, filterForbidden([]rune(charLower), p.cfg.ForbiddenChars))
+	}
+	if p.cfg.RequireUpper {
+		classes = append(classes, filterForbidden([]rune(charUpper), p.cfg.ForbiddenChars))
+	}
+	if p.cfg.RequireNumbers {
+		classes = append(classes, filterForbidden([]rune(charNumbers), p.cfg.ForbiddenChars))
+	}
+	if p.cfg.RequireSymbols {
+		symbols := filterForbidden([]rune(charSymbols), p.cfg.ForbiddenChars)
+		for i := 0; i < minCount(p.cfg.MinSymbolCount); i++ {
+			classes = append(classes, symbols)
+		}
+	}
+
+	return classes
+}
+
+func minCount(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+func countAny(b []byte, chars string) int {
+	n := 0
+	for _, c := range b {
+		if strings.ContainsRune(chars, rune(c)) {
+			n++
+		}
+	}
+	return n
+}
+
+// ShannonEntropyBits returns the Shannon entropy, in bits, of a password of
+// the given length drawn uniformly at random from an alphabet of charsetSize
+// characters.
+func ShannonEntropyBits(charsetSize, length int) float64 {
+	if charsetSize < 2 || length <= 0 {
+		return 0
+	}
+	return float64(length) * math.Log2(float64(charsetSize))
+}
+
+// minLengthForEntropy returns the shortest password length, drawn from an
+// alphabet of charsetSize characters, whose Shannon entropy meets minBits.
+func minLengthForEntropy(charsetSize int, minBits float64) int {
+	if minBits <= 0 || charsetSize < 2 {
+		return 0
+	}
+	return int(math.Ceil(minBits / math.Log2(float64(charsetSize))))
+}
+
+// GeneratePasswordWithPolicy generates a random password of at least length
+// characters (raised automatically if that length would fall below the
+// policy's MinEntropyBits) that satisfies policy.
+//
+// When policy can enumerate its required character classes, the password is
+// built constructively: one character is drawn from each required class, the
+// remainder from the full charset, and the result is shuffled with a
+// crypto/rand-backed Fisher–Yates shuffle, making generation O(length)
+// regardless of how restrictive the policy is. Policies that can't enumerate
+// their classes fall back to rejection sampling, as GeneratePassword always
+// has.
+func GeneratePasswordWithPolicy(policy PasswordPolicy, length int) (string, error) {
+	charset := policy.Charset()
+	if len(charset) < 2 {
+		return "", errors.New("password policy charset must contain at least two characters")
+	}
+
+	if min := minLengthForEntropy(len(charset), policy.MinEntropyBits()); length < min {
+		length = min
+	}
+
+	if cg, ok := policy.(classGenerator); ok {
+		requiredClasses := cg.requiredClasses()
+
+		for n := 0; n < 100; n++ {
+			result, err := generateConstructivePassword(charset, requiredClasses, length)
+			if err != nil {
+				return "", err
+			}
+
+			if policy.Validate([]byte(result)) == nil {
+				return result, nil
+			}
+		}
+
+		// The constructive draw kept failing Validate (e.g. forbidden_chars
+		// left a required class with no safe characters to draw from); fall
+		// back to rejection sampling below instead of returning a
+		// policy-violating password.
+	}
+
+	for n := 0; n < 100000; n++ {
+		result, err := generateRandomRunes(charset, length)
+		if err != nil {
+			return "", err
+		}
+
+		if policy.Validate([]byte(result)) == nil {
+			return result, nil
+		}
+	}
+
+	return "", errors.New("failed to generate acceptable password")
+}
+
+func generateConstructivePassword(charset []rune, requiredClasses [][]rune, length int) (string, error) {
+	if len(requiredClasses) > length {
+		length = len(requiredClasses)
+	}
+
+	result := make([]rune, length)
+
+	for i, class := range requiredClasses {
+		r, err := randomRune(class)
+		if err != nil {
+			return "", err
+		}
+		result[i] = r
+	}
+
+	for i := len(requiredClasses); i < length; i++ {
+		r, err := randomRune(charset)
+		if err != nil {
+			return "", err
+		}
+		result[i] = r
+	}
+
+	if err := shuffleRunes(result); err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+func randomRune(charset []rune) (rune, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
+
+func generateRandomRunes(charset []rune, length int) (string, error) {
+	result := make([]rune, length)
+	for i := range result {
+		r, err := randomRune(charset)
+		if err != nil {
+			return "", err
+		}
+		result[i] = r
+	}
+	return string(result), nil
+}
+
+// shuffleRunes performs an in-place Fisher–Yates shuffle using crypto/rand.
+func shuffleRunes(runes []rune) error {
+	for i := len(runes) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return err
+		}
+		runes[i], runes[j.Int64()] = runes[j.Int64()], runes[i]
+	}
+	return nil
+}
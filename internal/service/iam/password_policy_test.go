@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShannonEntropyBits(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		charsetSize int
+		length      int
+		want        float64
+	}{
+		"binary alphabet":  {charsetSize: 2, length: 8, want: 8},
+		"single character": {charsetSize: 1, length: 8, want: 0},
+		"zero length":      {charsetSize: 94, length: 0, want: 0},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ShannonEntropyBits(tt.charsetSize, tt.length); got != tt.want {
+				t.Errorf("ShannonEntropyBits(%d, %d) = %v, want %v", tt.charsetSize, tt.length, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinLengthForEntropy(t *testing.T) {
+	t.Parallel()
+
+	if got := minLengthForEntropy(2, 8); got != 8 {
+		t.Errorf("minLengthForEntropy(2, 8) = %d, want 8", got)
+	}
+	if got := minLengthForEntropy(2, 0); got != 0 {
+		t.Errorf("minLengthForEntropy(2, 0) = %d, want 0", got)
+	}
+	if got := minLengthForEntropy(94, 52); got < 8 {
+		t.Errorf("minLengthForEntropy(94, 52) = %d, want >= 8", got)
+	}
+}
+
+func TestGeneratePasswordWithPolicyDefault(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < 25; i++ {
+		password, err := GeneratePasswordWithPolicy(NewDefaultPasswordPolicy(), 12)
+		if err != nil {
+			t.Fatalf("GeneratePasswordWithPolicy() error = %v", err)
+		}
+		if len(password) != 12 {
+			t.Fatalf("GeneratePasswordWithPolicy() length = %d, want 12", len(password))
+		}
+		if !CheckPwdPolicy([]byte(password)) {
+			t.Fatalf("GeneratePasswordWithPolicy() = %q does not satisfy CheckPwdPolicy", password)
+		}
+	}
+}
+
+func TestGeneratePasswordWithPolicyForbiddenChars(t *testing.T) {
+	t.Parallel()
+
+	policy := NewPasswordPolicy(PasswordPolicyConfig{
+		RequireLower:   true,
+		RequireUpper:   true,
+		RequireNumbers: true,
+		ForbiddenChars: "aeiouAEIOU",
+	})
+
+	for i := 0; i < 50; i++ {
+		password, err := GeneratePasswordWithPolicy(policy, 16)
+		if err != nil {
+			t.Fatalf("GeneratePasswordWithPolicy() error = %v", err)
+		}
+
+		if err := policy.Validate([]byte(password)); err != nil {
+			t.Fatalf("GeneratePasswordWithPolicy() = %q violates its own policy: %s", password, err)
+		}
+
+		if strings.ContainsAny(password, "aeiouAEIOU") {
+			t.Fatalf("GeneratePasswordWithPolicy() = %q contains a forbidden vowel", password)
+		}
+	}
+}
+
+func TestGeneratePasswordWithPolicyMinEntropyRaisesLength(t *testing.T) {
+	t.Parallel()
+
+	policy := NewPasswordPolicy(PasswordPolicyConfig{
+		MinEntropyBits: 64,
+	})
+
+	password, err := GeneratePasswordWithPolicy(policy, 1)
+	if err != nil {
+		t.Fatalf("GeneratePasswordWithPolicy() error = %v", err)
+	}
+
+	charsetSize := len(policy.Charset())
+	if got, want := ShannonEntropyBits(charsetSize, len(password)), 64.0; got < want {
+		t.Errorf("GeneratePasswordWithPolicy() entropy = %v, want >= %v", got, want)
+	}
+}
+
+func TestConfigurablePasswordPolicyRequiredClassesRespectForbiddenChars(t *testing.T) {
+	t.Parallel()
+
+	policy := configurablePasswordPolicy{cfg: PasswordPolicyConfig{
+		RequireLower:   true,
+		RequireUpper:   true,
+		RequireNumbers: true,
+		RequireSymbols: true,
+		ForbiddenChars: "aeiouAEIOU",
+	}}
+
+	for _, class := range policy.requiredClasses() {
+		if strings.ContainsAny(string(class), "aeiouAEIOU") {
+			t.Errorf("requiredClasses() class %q contains a forbidden character", string(class))
+		}
+	}
+}
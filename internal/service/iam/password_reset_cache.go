@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import "sync"
+
+// resetCodeCache holds plaintext reset codes in memory only, keyed by a
+// random reference that is safe to persist in aws_iam_user_password_reset's
+// state. The plaintext itself never touches state: aws_iam_user_password_reset_email
+// looks it up by reference, and the lookup consumes the entry so it can't be
+// read twice. Because this cache is process-local, it's empty again in any
+// later provider process (the next separate terraform plan/apply); callers
+// must treat a miss as "no code available right now", not an error, since
+// Terraform refreshes data sources on every plan/apply, not just the one
+// that created the referencing resource.
+var resetCodeCache sync.Map
+
+// putResetCode stores code under a freshly generated reference and returns
+// that reference.
+func putResetCode(code string) (string, error) {
+	ref, err := generateRandomToken(resetCodeCharset, 32)
+	if err != nil {
+		return "", err
+	}
+
+	resetCodeCache.Store(ref, code)
+
+	return ref, nil
+}
+
+// takeResetCode returns the plaintext code stored under ref and removes it,
+// so it can only be fetched once. ok is false if ref is empty, unknown, or
+// already consumed.
+func takeResetCode(ref string) (code string, ok bool) {
+	if ref == "" {
+		return "", false
+	}
+
+	v, loaded := resetCodeCache.LoadAndDelete(ref)
+	if !loaded {
+		return "", false
+	}
+
+	return v.(string), true
+}
+
+// discardResetCode removes ref from the cache without returning its value,
+// invalidating a code that is being rotated or has expired before anyone
+// read it.
+func discardResetCode(ref string) {
+	if ref != "" {
+		resetCodeCache.Delete(ref)
+	}
+}
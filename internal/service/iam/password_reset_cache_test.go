@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import "testing"
+
+func TestResetCodeCacheTakeOnce(t *testing.T) {
+	t.Parallel()
+
+	ref, err := putResetCode("s3cr3t-code")
+	if err != nil {
+		t.Fatalf("putResetCode() error = %v", err)
+	}
+	if ref == "" {
+		t.Fatal("putResetCode() returned an empty reference")
+	}
+
+	code, ok := takeResetCode(ref)
+	if !ok {
+		t.Fatal("takeResetCode() ok = false, want true on first read")
+	}
+	if code != "s3cr3t-code" {
+		t.Errorf("takeResetCode() code = %q, want %q", code, "s3cr3t-code")
+	}
+
+	if _, ok := takeResetCode(ref); ok {
+		t.Error("takeResetCode() ok = true on second read, want false (one-time fetch)")
+	}
+}
+
+func TestResetCodeCacheTakeUnknownOrEmptyRef(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := takeResetCode(""); ok {
+		t.Error(`takeResetCode("") ok = true, want false`)
+	}
+	if _, ok := takeResetCode("does-not-exist"); ok {
+		t.Error(`takeResetCode("does-not-exist") ok = true, want false`)
+	}
+}
+
+func TestDiscardResetCode(t *testing.T) {
+	t.Parallel()
+
+	ref, err := putResetCode("another-code")
+	if err != nil {
+		t.Fatalf("putResetCode() error = %v", err)
+	}
+
+	discardResetCode(ref)
+
+	if _, ok := takeResetCode(ref); ok {
+		t.Error("takeResetCode() ok = true after discardResetCode(), want false")
+	}
+
+	// Must not panic on an empty or already-discarded reference.
+	discardResetCode("")
+	discardResetCode(ref)
+}
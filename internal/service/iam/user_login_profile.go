@@ -10,6 +10,7 @@ import (
 	"errors"
 	"log"
 	"math/big"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -33,6 +35,7 @@ func ResourceUserLoginProfile() *schema.Resource {
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("encrypted_password", "")
 				d.Set("key_fingerprint", "")
+				d.Set("recipient_fingerprint", "")
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -43,6 +46,12 @@ func ResourceUserLoginProfile() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			// pgp_key accepts a keybase handle, a base64-encoded or
+			// ASCII-armored PGP public key, an age X25519 recipient
+			// (age1...), an SSH public key (ssh-ed25519/ssh-rsa, as found in
+			// ~/.ssh/authorized_keys), or a KMS key ARN. encrypted_password
+			// is emitted in the format matching whichever of those was
+			// supplied: base64 PGP, age ASCII armor, or base64 KMS ciphertext.
 			"pgp_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -61,11 +70,57 @@ func ResourceUserLoginProfile() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(5, 128),
 			},
+			"require_lower": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"require_upper": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"require_numbers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"require_symbols": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"min_symbol_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"forbidden_chars": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"min_entropy_bits": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
 
 			"key_fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recipient_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"encrypted_password": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -85,42 +140,49 @@ const (
 	charSymbols = "!@#$%^&*()_+-=[]{}|'"
 )
 
-// GeneratePassword generates a random password of a given length, matching the
-// most restrictive iam password policy.
-func GeneratePassword(length int) (string, error) {
-	const charset = charLower + charUpper + charNumbers + charSymbols
-
+// generateRandomToken returns a random string of the given length drawn from
+// charset, using crypto/rand for every character.
+func generateRandomToken(charset string, length int) (string, error) {
 	result := make([]byte, length)
 	charsetSize := big.NewInt(int64(len(charset)))
 
-	// rather than trying to artificially add specific characters from each
-	// class to the password to match the policy, we generate passwords
-	// randomly and reject those that don't match.
-	//
-	// Even in the worst case, this tends to take less than 10 tries to find a
-	// matching password. Any sufficiently long password is likely to succeed
-	// on the first try
-	for n := 0; n < 100000; n++ {
-		for i := range result {
-			r, err := rand.Int(rand.Reader, charsetSize)
-			if err != nil {
-				return "", err
-			}
-			if !r.IsInt64() {
-				return "", errors.New("rand.Int() not representable as an Int64")
-			}
-
-			result[i] = charset[r.Int64()]
+	for i := range result {
+		r, err := rand.Int(rand.Reader, charsetSize)
+		if err != nil {
+			return "", err
 		}
-
-		if !CheckPwdPolicy(result) {
-			continue
+		if !r.IsInt64() {
+			return "", errors.New("rand.Int() not representable as an Int64")
 		}
 
-		return string(result), nil
+		result[i] = charset[r.Int64()]
 	}
 
-	return "", errors.New("failed to generate acceptable password")
+	return string(result), nil
+}
+
+// GeneratePassword generates a random password of a given length, matching the
+// most restrictive iam password policy.
+//
+// Deprecated: use GeneratePasswordWithPolicy(NewDefaultPasswordPolicy(), length)
+// directly, or build a PasswordPolicyConfig for a configurable policy.
+func GeneratePassword(length int) (string, error) {
+	return GeneratePasswordWithPolicy(NewDefaultPasswordPolicy(), length)
+}
+
+// passwordPolicyFromResourceData builds the PasswordPolicy described by an
+// aws_iam_user_login_profile resource's require_*/min_symbol_count/
+// forbidden_chars/min_entropy_bits attributes.
+func passwordPolicyFromResourceData(d *schema.ResourceData) PasswordPolicy {
+	return NewPasswordPolicy(PasswordPolicyConfig{
+		RequireLower:   d.Get("require_lower").(bool),
+		RequireUpper:   d.Get("require_upper").(bool),
+		RequireNumbers: d.Get("require_numbers").(bool),
+		RequireSymbols: d.Get("require_symbols").(bool),
+		MinSymbolCount: d.Get("min_symbol_count").(int),
+		ForbiddenChars: d.Get("forbidden_chars").(string),
+		MinEntropyBits: d.Get("min_entropy_bits").(float64),
+	})
 }
 
 // Check the generated password contains all character classes listed in the
@@ -132,13 +194,50 @@ func CheckPwdPolicy(pass []byte) bool {
 		bytes.ContainsAny(pass, charUpper))
 }
 
+// legacyPGPRecipient adapts the keybase-handle/base64-PGP-key path this
+// resource has always supported to the encryption.Recipient interface, so
+// Create has a single code path regardless of which recipient format the
+// operator supplied in pgp_key.
+type legacyPGPRecipient struct {
+	key string
+}
+
+func (r legacyPGPRecipient) Encrypt(plaintext []byte) (string, string, error) {
+	encryptionKey, err := retrieveGPGKey(r.key)
+	if err != nil {
+		return "", "", err
+	}
+
+	fingerprint, encrypted, err := encryptValue(encryptionKey, string(plaintext), "Password")
+	if err != nil {
+		return "", "", err
+	}
+
+	return encrypted, fingerprint, nil
+}
+
+// userLoginProfileRecipient resolves the pgp_key attribute to an
+// encryption.Recipient, dispatching to age, SSH, or KMS recipients when
+// pgp_key is in one of those formats and falling back to the historical
+// keybase handle/base64 PGP key handling otherwise.
+func userLoginProfileRecipient(client *conns.AWSClient, pgpKey string) (encryption.Recipient, error) {
+	switch {
+	case strings.HasPrefix(pgpKey, "age1"), strings.HasPrefix(pgpKey, "ssh-"):
+		return encryption.ParseRecipient(pgpKey)
+	case strings.HasPrefix(pgpKey, "arn:") && strings.Contains(pgpKey, ":kms:"):
+		return encryption.NewKMSRecipient(client.KMSConn(), pgpKey), nil
+	default:
+		return legacyPGPRecipient{key: pgpKey}, nil
+	}
+}
+
 func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMConn()
 	username := d.Get("user").(string)
 
 	passwordLength := d.Get("password_length").(int)
-	initialPassword, err := GeneratePassword(passwordLength)
+	initialPassword, err := GeneratePasswordWithPolicy(passwordPolicyFromResourceData(d), passwordLength)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
 	}
@@ -157,17 +256,18 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(aws.StringValue(createResp.LoginProfile.UserName))
 
 	if v, ok := d.GetOk("pgp_key"); ok {
-		encryptionKey, err := retrieveGPGKey(v.(string))
+		recipient, err := userLoginProfileRecipient(meta.(*conns.AWSClient), v.(string))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
 		}
 
-		fingerprint, encrypted, err := encryptValue(encryptionKey, initialPassword, "Password")
+		encrypted, fingerprint, err := recipient.Encrypt([]byte(initialPassword))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
 		}
 
 		d.Set("key_fingerprint", fingerprint)
+		d.Set("recipient_fingerprint", fingerprint)
 		d.Set("encrypted_password", encrypted)
 	} else {
 		d.Set("password", initialPassword)
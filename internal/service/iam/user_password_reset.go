@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// resetCodeCharset excludes visually ambiguous characters (0/O, 1/I/l) since
+// the code is meant to be read out of an email and retyped by a human.
+const resetCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// @SDKResource("aws_iam_user_password_reset")
+func ResourceUserPasswordReset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPasswordResetCreate,
+		ReadWithoutTimeout:   resourceUserPasswordResetRead,
+		UpdateWithoutTimeout: resourceUserPasswordResetUpdate,
+		DeleteWithoutTimeout: resourceUserPasswordResetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"code_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(6, 32),
+			},
+			"cooldown": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "15m",
+				ValidateFunc: validateDuration,
+			},
+			"timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1h",
+				ValidateFunc: validateDuration,
+			},
+
+			"code_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issued_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// reset_code_ref is an opaque lookup key into an in-process,
+			// in-memory cache (see password_reset_cache.go), not the
+			// plaintext code itself: the plaintext must never be written to
+			// state. aws_iam_user_password_reset_email resolves it, within
+			// the same apply, to render the one-time email.
+			"reset_code_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateDuration validates that a string is parseable by time.ParseDuration.
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid duration: %w", k, err))
+	}
+	return
+}
+
+func resourceUserPasswordResetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+	username := d.Get("user").(string)
+
+	if err := issuePasswordReset(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IAM User Password Reset for %q: %s", username, err)
+	}
+
+	d.SetId(username)
+
+	return resourceUserPasswordResetRead(ctx, d, meta)
+}
+
+func resourceUserPasswordResetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	_, err := conn.GetLoginProfileWithContext(ctx, &iam.GetLoginProfileInput{
+		UserName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		log.Printf("[WARN] IAM User Login Profile (%s) not found, removing IAM User Password Reset from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Password Reset (%s): %s", d.Id(), err)
+	}
+
+	d.Set("user", d.Id())
+
+	// Once the code has been valid for longer than timeout, it can no
+	// longer be fetched: drop the reference so
+	// aws_iam_user_password_reset_email reports available = false instead
+	// of rendering an expired code.
+	if issuedAt, err := time.Parse(time.RFC3339, d.Get("issued_at").(string)); err == nil {
+		if timeout, err := time.ParseDuration(d.Get("timeout").(string)); err == nil && timeout > 0 {
+			if time.Since(issuedAt) > timeout {
+				discardResetCode(d.Get("reset_code_ref").(string))
+				d.Set("reset_code_ref", "")
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceUserPasswordResetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+	username := d.Get("user").(string)
+
+	// cooldown and timeout are mutable but don't themselves invalidate the
+	// current code; only a trigger change (or -replace, which ForceNew
+	// attributes would handle) should rotate the live IAM password.
+	if !d.HasChange("trigger") {
+		return resourceUserPasswordResetRead(ctx, d, meta)
+	}
+
+	if issuedAt, err := time.Parse(time.RFC3339, d.Get("issued_at").(string)); err == nil {
+		cooldown, _ := time.ParseDuration(d.Get("cooldown").(string))
+		if remaining := cooldown - time.Since(issuedAt); remaining > 0 {
+			return sdkdiag.AppendErrorf(diags, "reissuing IAM User Password Reset for %q: cooldown active, %s remaining", username, remaining)
+		}
+	}
+
+	oldRef := d.Get("reset_code_ref").(string)
+
+	if err := issuePasswordReset(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating IAM User Password Reset for %q: %s", username, err)
+	}
+
+	discardResetCode(oldRef)
+
+	return resourceUserPasswordResetRead(ctx, d, meta)
+}
+
+func resourceUserPasswordResetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The reset code only exists as a one-time IAM login profile password and
+	// has no independent lifecycle in the API; removing this resource simply
+	// stops Terraform from tracking and reissuing it.
+	discardResetCode(d.Get("reset_code_ref").(string))
+	return nil
+}
+
+// issuePasswordReset generates a new single-use reset code, rotates the
+// user's IAM login profile password to it via UpdateLoginProfile, and
+// stores only the code's bcrypt hash in state. The plaintext code is kept
+// in the in-memory resetCodeCache (see password_reset_cache.go) under a
+// reference that is stored in state instead of the code itself.
+func issuePasswordReset(ctx context.Context, conn *iam.IAM, d *schema.ResourceData) error {
+	username := d.Get("user").(string)
+	codeLength := d.Get("code_length").(int)
+
+	code, err := generateRandomToken(resetCodeCharset, codeLength)
+	if err != nil {
+		return fmt.Errorf("generating reset code: %w", err)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hashing reset code: %w", err)
+	}
+
+	_, err = conn.UpdateLoginProfileWithContext(ctx, &iam.UpdateLoginProfileInput{
+		UserName:              aws.String(username),
+		Password:              aws.String(code),
+		PasswordResetRequired: aws.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	ref, err := putResetCode(code)
+	if err != nil {
+		return fmt.Errorf("caching reset code: %w", err)
+	}
+
+	d.Set("code_hash", string(hash))
+	d.Set("issued_at", time.Now().UTC().Format(time.RFC3339))
+	d.Set("reset_code_ref", ref)
+
+	return nil
+}
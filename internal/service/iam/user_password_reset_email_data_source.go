@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKDataSource("aws_iam_user_password_reset_email")
+func DataSourceUserPasswordResetEmail() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUserPasswordResetEmailRead,
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"reset_code_ref": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"body": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			// available reports whether this read actually delivered a fresh
+			// code. It's false, with subject/body left empty, on every read
+			// after the one that consumed reset_code_ref's cache entry:
+			// Terraform refreshes data sources on every plan/apply, not just
+			// the one that created the referencing resource, and this
+			// provider process's in-memory cache can't (and mustn't, since
+			// the whole point is to keep the code out of state) survive
+			// across separate plan/apply invocations. Configs that need to
+			// act on the email (e.g. actually send it) should condition on
+			// available rather than assume body is always populated.
+			"available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceUserPasswordResetEmailRead renders the email body for an
+// aws_iam_user_password_reset code, if one is still available. reset_code_ref
+// (typically `aws_iam_user_password_reset.example.reset_code_ref`) is a
+// lookup key into an in-memory cache, not the plaintext itself, so the code
+// is never written to either resource's state. The lookup consumes the
+// cache entry, so only the read that first observes a given reset_code_ref
+// gets the code; every later read of the same config (a second apply with
+// no changes, a CI drift check, a plan in a new provider process, ...) is a
+// no-op that reports available = false instead of failing the plan.
+func dataSourceUserPasswordResetEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	username := d.Get("user").(string)
+	ref := d.Get("reset_code_ref").(string)
+
+	d.SetId(username)
+
+	code, ok := takeResetCode(ref)
+	if !ok {
+		log.Printf("[DEBUG] IAM User Password Reset Email for %q: reset code is unavailable; it has already been read, expired, or was issued by a different provider process", username)
+		d.Set("subject", "")
+		d.Set("body", "")
+		d.Set("available", false)
+		return diags
+	}
+
+	d.Set("subject", fmt.Sprintf("Your password reset code for %s", username))
+	d.Set("body", fmt.Sprintf(
+		"Hello %s,\n\nUse the following one-time code to reset your AWS console password:\n\n    %s\n\nThis code expires after the timeout configured on your aws_iam_user_password_reset resource and cannot be reused.\n",
+		username, code,
+	))
+	d.Set("available", true)
+
+	return diags
+}
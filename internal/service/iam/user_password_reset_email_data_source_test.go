@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceUserPasswordResetEmailReadUnavailableIsNoOp(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, DataSourceUserPasswordResetEmail().Schema, map[string]interface{}{
+		"user":           "example-user",
+		"reset_code_ref": "does-not-exist",
+	})
+
+	diags := dataSourceUserPasswordResetEmailRead(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("dataSourceUserPasswordResetEmailRead() with an unknown/consumed reset_code_ref errored instead of being a no-op: %v", diags)
+	}
+
+	if got := d.Get("available").(bool); got {
+		t.Error(`Get("available") = true, want false`)
+	}
+	if got := d.Get("subject").(string); got != "" {
+		t.Errorf(`Get("subject") = %q, want ""`, got)
+	}
+	if got := d.Get("body").(string); got != "" {
+		t.Errorf(`Get("body") = %q, want ""`, got)
+	}
+}
+
+func TestDataSourceUserPasswordResetEmailReadAvailable(t *testing.T) {
+	t.Parallel()
+
+	ref, err := putResetCode("TESTCODE123")
+	if err != nil {
+		t.Fatalf("putResetCode() error = %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceUserPasswordResetEmail().Schema, map[string]interface{}{
+		"user":           "example-user",
+		"reset_code_ref": ref,
+	})
+
+	diags := dataSourceUserPasswordResetEmailRead(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("dataSourceUserPasswordResetEmailRead() error = %v", diags)
+	}
+
+	if got := d.Get("available").(bool); !got {
+		t.Error(`Get("available") = false, want true`)
+	}
+	if got := d.Get("body").(string); got == "" {
+		t.Error(`Get("body") is empty, want the rendered email body`)
+	}
+
+	// The cache entry is one-time use: a second read of the same ref must
+	// be a no-op, not an error, matching the unavailable case above.
+	diags = dataSourceUserPasswordResetEmailRead(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("second dataSourceUserPasswordResetEmailRead() error = %v", diags)
+	}
+	if got := d.Get("available").(bool); got {
+		t.Error(`second read Get("available") = true, want false`)
+	}
+}